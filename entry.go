@@ -0,0 +1,176 @@
+package logging
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Entry is the structured representation of a single log record. It is
+// built once per emitted line so that a Formatter never has to re-parse
+// the message or re-derive caller information. Entries are pooled
+// (see entryPool), so a Formatter must not retain one past Format.
+type Entry struct {
+	Level       *Level
+	Time        time.Time
+	Message     string
+	File        string
+	Line        int
+	Function    string
+	GoroutineID string
+	Fields      []*Field
+
+	// buf is formatter scratch space, reused across Pool checkouts so
+	// rendering a record doesn't allocate a fresh buffer every time.
+	buf []byte
+}
+
+// entryPool recycles *Entry values (and their backing Fields/buf
+// slices) across log calls, so the hot, enabled path reuses an Entry
+// rather than allocating a fresh one every time.
+var entryPool = sync.Pool{
+	New: func() interface{} {
+		return &Entry{
+			Fields: make([]*Field, 0, 8),
+			buf:    make([]byte, 0, 256),
+		}
+	},
+}
+
+func acquireEntry() *Entry {
+	return entryPool.Get().(*Entry)
+}
+
+// releaseEntry returns entry to entryPool. Callers must not use entry,
+// or any []byte previously returned by a Formatter for it, afterward.
+func releaseEntry(entry *Entry) {
+	entry.Level = nil
+	entry.Message = ""
+	entry.File = ""
+	entry.Line = 0
+	entry.Function = ""
+	entry.GoroutineID = ""
+	entry.Fields = entry.Fields[:0]
+	entryPool.Put(entry)
+}
+
+// Formatter renders an Entry into the bytes written to a logger's output.
+type Formatter interface {
+	Format(entry *Entry) ([]byte, error)
+}
+
+// textFormatter reproduces the original colored, space-separated format.
+type textFormatter struct{}
+
+// NewTextFormatter returns the default colored text Formatter.
+func NewTextFormatter() Formatter {
+	return &textFormatter{}
+}
+
+func (f *textFormatter) Format(entry *Entry) ([]byte, error) {
+	msg := entry.Message
+	if fieldText := sprintFields(entry.Fields); fieldText != "" {
+		if msg != "" {
+			msg += ", " + fieldText
+		} else {
+			msg = fieldText
+		}
+	}
+	if len(msg) != 0 {
+		msg = " " + msg
+	}
+
+	entry.buf = fmt.Appendf(
+		entry.buf[:0],
+		"%s %s%s:%s %s:%s:%s {git:%s, build:%s}\n",
+		entry.Level.colorFunc("["+entry.Level.name+"]"),
+		goTagColorFunc("Go"),
+		goIDColorFunc(entry.GoroutineID),
+		msg,
+		funcNameColorFunc(entry.Function+"()"),
+		fileColorFunc(entry.File),
+		lineColorFunc(entry.Line),
+		gitVersion,
+		buildVersion)
+	return entry.buf, nil
+}
+
+// jsonFormatter emits one JSON object per line, modeled on hclog's
+// intlogger: well-known "@"-prefixed keys alongside the record's fields
+// flattened at the top level.
+type jsonFormatter struct{}
+
+// NewJSONFormatter returns a Formatter that emits structured JSON lines.
+func NewJSONFormatter() Formatter {
+	return &jsonFormatter{}
+}
+
+func (f *jsonFormatter) Format(entry *Entry) ([]byte, error) {
+	out := make(map[string]interface{}, 6+len(entry.Fields))
+	out["@timestamp"] = entry.Time.Format("2006-01-02T15:04:05.000Z07:00")
+	out["@level"] = entry.Level.name
+	out["@message"] = entry.Message
+	out["@caller"] = fmt.Sprintf("%s:%d", entry.File, entry.Line)
+	out["@function"] = entry.Function
+	out["@goroutine"] = entry.GoroutineID
+
+	for _, field := range entry.Fields {
+		for _, key := range field.keys {
+			out[key] = field.values[key]
+		}
+	}
+
+	encoded, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	entry.buf = append(entry.buf[:0], encoded...)
+	entry.buf = append(entry.buf, '\n')
+	return entry.buf, nil
+}
+
+// sprintFields renders fields as comma-separated k(v) text, the form
+// textFormatter has always used. It's the only place Field.Sprint()'s
+// stringified rendering is still used — jsonFormatter.Format above
+// takes field values typed, straight from field.values.
+func sprintFields(fields []*Field) string {
+	var rendered []interface{}
+	for _, field := range fields {
+		rendered = append(rendered, field.Sprint()...)
+	}
+	if len(rendered) == 0 {
+		return ""
+	}
+
+	formedList := make([]interface{}, 0, len(rendered)*2)
+	for _, value := range rendered {
+		formedList = append(formedList, value, ", ")
+	}
+	return fmt.Sprint(formedList[:len(formedList)-1]...)
+}
+
+// fieldsOf merges every *Field found in v, in order, into a single
+// *Field, without stringifying their values. Merging by key here
+// (rather than handing each *Field to the formatter separately) is
+// what makes "a later, more specific Field shadows an earlier one"
+// true for every Formatter, not just jsonFormatter's incidental
+// map-keyed output.
+func fieldsOf(v []interface{}) []*Field {
+	merged := NewField()
+	found := false
+	for _, value := range v {
+		field, ok := value.(*Field)
+		if !ok {
+			continue
+		}
+		found = true
+		for _, key := range field.keys {
+			merged.set(key, field.values[key])
+		}
+	}
+	if !found {
+		return nil
+	}
+	return []*Field{merged}
+}
@@ -0,0 +1,93 @@
+package logging
+
+import (
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/fatih/color"
+	"github.com/stretchr/testify/suite"
+)
+
+type EntryPoolSuite struct {
+	suite.Suite
+}
+
+// syncWriter serializes Write calls so a concurrency test can target
+// entryPool and minPriority without tripping over the unrelated fact
+// that strings.Builder itself isn't safe for concurrent writers.
+type syncWriter struct {
+	mu sync.Mutex
+	w  strings.Builder
+}
+
+func (s *syncWriter) Write(p []byte) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.w.Write(p)
+}
+
+func (suite *EntryPoolSuite) TestReleaseResetsEntryForReuse() {
+	entry := acquireEntry()
+	entry.Level = level.error
+	entry.Message = "boom"
+	entry.File = "x.go"
+	entry.Line = 42
+	entry.Function = "doStuff"
+	entry.GoroutineID = "7"
+	entry.Fields = append(entry.Fields, NewField("k", "v"))
+	releaseEntry(entry)
+
+	suite.Require().Nil(entry.Level)
+	suite.Require().Equal("", entry.Message)
+	suite.Require().Equal("", entry.File)
+	suite.Require().Equal(0, entry.Line)
+	suite.Require().Equal("", entry.Function)
+	suite.Require().Equal("", entry.GoroutineID)
+	suite.Require().Empty(entry.Fields)
+}
+
+func (suite *EntryPoolSuite) TestReusedEntryDoesNotLeakFieldsAcrossLines() {
+	color.NoColor = true
+	defer func() { color.NoColor = false }()
+
+	logger, err := NewLogger()
+	suite.Require().NoError(err)
+	concrete := logger.(*concreteLogger)
+	var buffer strings.Builder
+	concrete.SetOutput(&buffer)
+
+	concrete.Info(NewField("req_id", 1))
+	concrete.Info("no fields here")
+	output := buffer.String()
+	suite.Require().NotContains(
+		strings.Split(output, "\n")[1], "req_id")
+}
+
+func (suite *EntryPoolSuite) TestConcurrentLoggingAndSetMinLevelIsRaceFree() {
+	logger, err := NewLogger()
+	suite.Require().NoError(err)
+	concrete := logger.(*concreteLogger)
+	concrete.SetOutput(new(syncWriter))
+
+	prior := minPriority.Load()
+	defer minPriority.Store(prior)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(2)
+		go func(i int) {
+			defer wg.Done()
+			concrete.Info(NewField("i", i))
+		}(i)
+		go func() {
+			defer wg.Done()
+			SetMinLevel(level.info.name)
+		}()
+	}
+	wg.Wait()
+}
+
+func TestEntryPool(t *testing.T) {
+	suite.Run(t, new(EntryPoolSuite))
+}
@@ -0,0 +1,326 @@
+package logging
+
+// Filter wraps a Logger, applying level, key, value, and custom
+// predicates to a record before it reaches the wrapped Logger. Because
+// Filter itself implements Logger (and LoggerWithDepth, forwarding to
+// next one frame deeper), filters compose: wrap a Filter in another
+// Filter to layer additional policy.
+type Filter struct {
+	next       Logger
+	minLevel   *Level
+	keys       map[string]bool
+	values     map[string]bool
+	predicates []func(level *Level, msg string, fields []*Field) bool
+}
+
+// FilterOption configures a Filter created by NewFilter.
+type FilterOption func(*Filter)
+
+// FilterLevel drops any record whose level is below minLevel.
+func FilterLevel(minLevel *Level) FilterOption {
+	return func(f *Filter) {
+		f.minLevel = minLevel
+	}
+}
+
+// FilterKey replaces the value of any Field whose key matches one of
+// keys with "***" before the record is forwarded.
+func FilterKey(keys ...string) FilterOption {
+	return func(f *Filter) {
+		for _, key := range keys {
+			f.keys[key] = true
+		}
+	}
+}
+
+// FilterValue replaces any Field value equal to one of values with
+// "***" before the record is forwarded.
+func FilterValue(values ...string) FilterOption {
+	return func(f *Filter) {
+		for _, value := range values {
+			f.values[value] = true
+		}
+	}
+}
+
+// FilterFunc drops a record if fn returns true. fn receives the
+// record's level, rendered message, and sanitized fields.
+func FilterFunc(
+	fn func(level *Level, msg string, fields []*Field) bool) FilterOption {
+	return func(f *Filter) {
+		f.predicates = append(f.predicates, fn)
+	}
+}
+
+// NewFilter returns a Logger that applies opts to every record before
+// forwarding it to next.
+func NewFilter(next Logger, opts ...FilterOption) *Filter {
+	f := &Filter{
+		next:   next,
+		keys:   make(map[string]bool),
+		values: make(map[string]bool),
+	}
+	for _, opt := range opts {
+		opt(f)
+	}
+	return f
+}
+
+// sanitize returns a clone of field with any matching key or value
+// replaced by "***", leaving the caller's Field untouched.
+func (f *Filter) sanitize(field *Field) *Field {
+	clone := NewField()
+	for _, key := range field.keys {
+		value := field.values[key]
+		if f.keys[key] {
+			value = "***"
+		} else if str, ok := value.(string); ok && f.values[str] {
+			value = "***"
+		}
+		clone.Add(key, value)
+	}
+	return clone
+}
+
+// sprintSlice adapts sprint's variadic signature to the msgOf shape
+// process expects.
+func sprintSlice(v []interface{}) string {
+	return sprint(v...)
+}
+
+// process applies the Filter's policy to v, returning a sanitized copy
+// and whether the record should still be forwarded. msgOf is only
+// invoked (on the sanitized clone) when the Filter actually has
+// predicates to run. The message it renders holds only the non-Field
+// values, which the Filter does not redact; Field values reach
+// FilterFunc, already redacted, through its fields argument.
+func (f *Filter) process(
+	lvl *Level, v []interface{}, msgOf func(cloned []interface{}) string,
+) ([]interface{}, bool) {
+	if f.minLevel != nil && lvl.priority < f.minLevel.priority {
+		return nil, false
+	}
+
+	cloned := make([]interface{}, len(v))
+	copy(cloned, v)
+	for idx, value := range cloned {
+		field, ok := value.(*Field)
+		if !ok {
+			continue
+		}
+		cloned[idx] = f.sanitize(field)
+	}
+
+	if len(f.predicates) != 0 {
+		msg := msgOf(cloned)
+		for _, predicate := range f.predicates {
+			if predicate(lvl, msg, fieldsOf(cloned)) {
+				return nil, false
+			}
+		}
+	}
+
+	return cloned, true
+}
+
+func (f *Filter) Fatal(v ...interface{}) {
+	f.Fatald(3, v...)
+}
+
+func (f *Filter) Fatalf(format string, v ...interface{}) {
+	f.Fataldf(3, format, v...)
+}
+
+func (f *Filter) Error(v ...interface{}) {
+	f.Errord(3, v...)
+}
+
+func (f *Filter) Errorf(format string, v ...interface{}) {
+	f.Errordf(3, format, v...)
+}
+
+func (f *Filter) Warn(v ...interface{}) {
+	f.Warnd(3, v...)
+}
+
+func (f *Filter) Warnf(format string, v ...interface{}) {
+	f.Warndf(3, format, v...)
+}
+
+func (f *Filter) Notice(v ...interface{}) {
+	f.Noticed(3, v...)
+}
+
+func (f *Filter) Noticef(format string, v ...interface{}) {
+	f.Noticedf(3, format, v...)
+}
+
+func (f *Filter) Info(v ...interface{}) {
+	f.Infod(3, v...)
+}
+
+func (f *Filter) Infof(format string, v ...interface{}) {
+	f.Infodf(3, format, v...)
+}
+
+func (f *Filter) Debug(v ...interface{}) {
+	f.Debugd(3, v...)
+}
+
+func (f *Filter) Debugf(format string, v ...interface{}) {
+	f.Debugdf(3, format, v...)
+}
+
+// Fatald applies the Filter's policy and, when next also implements
+// LoggerWithDepth, forwards through its Xd method at depth+1 so caller
+// attribution skips over this frame; otherwise it falls back to next's
+// plain, depth-unaware method.
+func (f *Filter) Fatald(depth int, v ...interface{}) {
+	cloned, ok := f.process(level.fatal, v, sprintSlice)
+	if !ok {
+		return
+	}
+	if withDepth, ok := f.next.(LoggerWithDepth); ok {
+		withDepth.Fatald(depth+1, cloned...)
+		return
+	}
+	f.next.Fatal(cloned...)
+}
+
+func (f *Filter) Fataldf(depth int, format string, v ...interface{}) {
+	cloned, ok := f.process(
+		level.fatal, v, func(c []interface{}) string { return sprintf(format, c...) })
+	if !ok {
+		return
+	}
+	if withDepth, ok := f.next.(LoggerWithDepth); ok {
+		withDepth.Fataldf(depth+1, format, cloned...)
+		return
+	}
+	f.next.Fatalf(format, cloned...)
+}
+
+func (f *Filter) Errord(depth int, v ...interface{}) {
+	cloned, ok := f.process(level.error, v, sprintSlice)
+	if !ok {
+		return
+	}
+	if withDepth, ok := f.next.(LoggerWithDepth); ok {
+		withDepth.Errord(depth+1, cloned...)
+		return
+	}
+	f.next.Error(cloned...)
+}
+
+func (f *Filter) Errordf(depth int, format string, v ...interface{}) {
+	cloned, ok := f.process(
+		level.error, v, func(c []interface{}) string { return sprintf(format, c...) })
+	if !ok {
+		return
+	}
+	if withDepth, ok := f.next.(LoggerWithDepth); ok {
+		withDepth.Errordf(depth+1, format, cloned...)
+		return
+	}
+	f.next.Errorf(format, cloned...)
+}
+
+func (f *Filter) Warnd(depth int, v ...interface{}) {
+	cloned, ok := f.process(level.warn, v, sprintSlice)
+	if !ok {
+		return
+	}
+	if withDepth, ok := f.next.(LoggerWithDepth); ok {
+		withDepth.Warnd(depth+1, cloned...)
+		return
+	}
+	f.next.Warn(cloned...)
+}
+
+func (f *Filter) Warndf(depth int, format string, v ...interface{}) {
+	cloned, ok := f.process(
+		level.warn, v, func(c []interface{}) string { return sprintf(format, c...) })
+	if !ok {
+		return
+	}
+	if withDepth, ok := f.next.(LoggerWithDepth); ok {
+		withDepth.Warndf(depth+1, format, cloned...)
+		return
+	}
+	f.next.Warnf(format, cloned...)
+}
+
+func (f *Filter) Noticed(depth int, v ...interface{}) {
+	cloned, ok := f.process(level.notice, v, sprintSlice)
+	if !ok {
+		return
+	}
+	if withDepth, ok := f.next.(LoggerWithDepth); ok {
+		withDepth.Noticed(depth+1, cloned...)
+		return
+	}
+	f.next.Notice(cloned...)
+}
+
+func (f *Filter) Noticedf(depth int, format string, v ...interface{}) {
+	cloned, ok := f.process(
+		level.notice, v, func(c []interface{}) string { return sprintf(format, c...) })
+	if !ok {
+		return
+	}
+	if withDepth, ok := f.next.(LoggerWithDepth); ok {
+		withDepth.Noticedf(depth+1, format, cloned...)
+		return
+	}
+	f.next.Noticef(format, cloned...)
+}
+
+func (f *Filter) Infod(depth int, v ...interface{}) {
+	cloned, ok := f.process(level.info, v, sprintSlice)
+	if !ok {
+		return
+	}
+	if withDepth, ok := f.next.(LoggerWithDepth); ok {
+		withDepth.Infod(depth+1, cloned...)
+		return
+	}
+	f.next.Info(cloned...)
+}
+
+func (f *Filter) Infodf(depth int, format string, v ...interface{}) {
+	cloned, ok := f.process(
+		level.info, v, func(c []interface{}) string { return sprintf(format, c...) })
+	if !ok {
+		return
+	}
+	if withDepth, ok := f.next.(LoggerWithDepth); ok {
+		withDepth.Infodf(depth+1, format, cloned...)
+		return
+	}
+	f.next.Infof(format, cloned...)
+}
+
+func (f *Filter) Debugd(depth int, v ...interface{}) {
+	cloned, ok := f.process(level.debug, v, sprintSlice)
+	if !ok {
+		return
+	}
+	if withDepth, ok := f.next.(LoggerWithDepth); ok {
+		withDepth.Debugd(depth+1, cloned...)
+		return
+	}
+	f.next.Debug(cloned...)
+}
+
+func (f *Filter) Debugdf(depth int, format string, v ...interface{}) {
+	cloned, ok := f.process(
+		level.debug, v, func(c []interface{}) string { return sprintf(format, c...) })
+	if !ok {
+		return
+	}
+	if withDepth, ok := f.next.(LoggerWithDepth); ok {
+		withDepth.Debugdf(depth+1, format, cloned...)
+		return
+	}
+	f.next.Debugf(format, cloned...)
+}
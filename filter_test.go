@@ -0,0 +1,110 @@
+package logging
+
+import (
+	"fmt"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/fatih/color"
+	"github.com/stretchr/testify/suite"
+)
+
+type FilterSuite struct {
+	suite.Suite
+}
+
+func (suite *FilterSuite) newFiltered(
+	opts ...FilterOption) (*Filter, *strings.Builder) {
+	base := new(concreteLogger)
+	base.formatter = NewTextFormatter()
+	buffer := &strings.Builder{}
+	base.output = buffer
+	return NewFilter(Logger(base), opts...), buffer
+}
+
+func (suite *FilterSuite) TestFilterKeyRedactsValue() {
+	color.NoColor = true
+	defer func() { color.NoColor = false }()
+
+	filtered, buffer := suite.newFiltered(FilterKey("password"))
+	filtered.Info(NewField("password", "hunter2", "user", "alice"))
+	output := buffer.String()
+	suite.Require().NotContains(output, "hunter2")
+	suite.Require().Contains(output, "***")
+	suite.Require().Contains(output, "alice")
+}
+
+func (suite *FilterSuite) TestFilterValueRedactsMatchingValue() {
+	color.NoColor = true
+	defer func() { color.NoColor = false }()
+
+	filtered, buffer := suite.newFiltered(FilterValue("secret-token"))
+	filtered.Info(NewField("token", "secret-token"))
+	output := buffer.String()
+	suite.Require().NotContains(output, "secret-token")
+	suite.Require().Contains(output, "***")
+}
+
+func (suite *FilterSuite) TestFilterLevelDropsBelowMinLevel() {
+	color.NoColor = true
+	defer func() { color.NoColor = false }()
+
+	filtered, buffer := suite.newFiltered(FilterLevel(level.warn))
+	filtered.Info("should be dropped")
+	suite.Require().Empty(buffer.String())
+
+	filtered.Warn("should pass")
+	suite.Require().Contains(buffer.String(), "should pass")
+}
+
+func (suite *FilterSuite) TestFilterFuncSeesSanitizedMessage() {
+	color.NoColor = true
+	defer func() { color.NoColor = false }()
+
+	var seenMsg string
+	filtered, buffer := suite.newFiltered(
+		FilterKey("password"),
+		FilterFunc(func(lvl *Level, msg string, fields []*Field) bool {
+			seenMsg = msg
+			return false
+		}))
+
+	filtered.Info(NewField("password", "hunter2"))
+	suite.Require().NotContains(seenMsg, "hunter2")
+	suite.Require().NotContains(buffer.String(), "hunter2")
+}
+
+func (suite *FilterSuite) TestFilterFuncDropsRecord() {
+	color.NoColor = true
+	defer func() { color.NoColor = false }()
+
+	filtered, buffer := suite.newFiltered(
+		FilterFunc(func(lvl *Level, msg string, fields []*Field) bool {
+			return lvl == level.debug
+		}))
+
+	filtered.Debug("dropped")
+	suite.Require().Empty(buffer.String())
+
+	filtered.Info("kept")
+	suite.Require().Contains(buffer.String(), "kept")
+}
+
+func (suite *FilterSuite) TestFilterPreservesCallerAttribution() {
+	color.NoColor = true
+	defer func() { color.NoColor = false }()
+
+	filtered, buffer := suite.newFiltered()
+	_, file, line, ok := runtime.Caller(0)
+	suite.Require().True(ok)
+	filtered.Info("hello")
+	output := buffer.String()
+	suite.Require().Contains(output, filepath.Base(file))
+	suite.Require().Contains(output, fmt.Sprintf("%d", line+2))
+}
+
+func TestFilter(t *testing.T) {
+	suite.Run(t, new(FilterSuite))
+}
@@ -0,0 +1,51 @@
+package logging
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/fatih/color"
+	"github.com/stretchr/testify/suite"
+)
+
+type FormatSuite struct {
+	suite.Suite
+}
+
+func (suite *FormatSuite) newConcrete() (*concreteLogger, *strings.Builder) {
+	logger, err := NewLogger()
+	suite.Require().NoError(err)
+	concrete := logger.(*concreteLogger)
+	buffer := &strings.Builder{}
+	concrete.SetOutput(buffer)
+	return concrete, buffer
+}
+
+func (suite *FormatSuite) TestJSONMessageExcludesFieldText() {
+	logger, buffer := suite.newConcrete()
+	logger.SetFormatter(NewJSONFormatter())
+
+	logger.Info("request handled", NewField("req_id", 42, "status", 200))
+
+	var record map[string]interface{}
+	suite.Require().NoError(json.Unmarshal([]byte(buffer.String()), &record))
+	suite.Require().Equal("request handled", record["@message"])
+	suite.Require().Equal(float64(42), record["req_id"])
+	suite.Require().Equal(float64(200), record["status"])
+}
+
+func (suite *FormatSuite) TestTextMessageStillRendersFieldText() {
+	color.NoColor = true
+	defer func() { color.NoColor = false }()
+
+	logger, buffer := suite.newConcrete()
+	logger.Info("request handled", NewField("req_id", 42))
+
+	output := buffer.String()
+	suite.Require().Contains(output, "request handled, req_id(42)")
+}
+
+func TestFormat(t *testing.T) {
+	suite.Run(t, new(FormatSuite))
+}
@@ -0,0 +1,101 @@
+package logging
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/pflag"
+)
+
+var _ pflag.Value = (*LogLevelFlag)(nil)
+
+// levelByName maps every accepted level name, including the aliases
+// taken by LogLevelFlag, and a numeric priority string, to the
+// canonical *Level.
+var levelByName = map[string]*Level{
+	"fatal": level.fatal,
+	"5":     level.fatal,
+
+	"error": level.error,
+	"err":   level.error,
+	"4":     level.error,
+
+	"warn":    level.warn,
+	"warning": level.warn,
+	"3":       level.warn,
+
+	"notice": level.notice,
+	"2":      level.notice,
+
+	"info": level.info,
+	"1":    level.info,
+
+	"debug": level.debug,
+	"dbg":   level.debug,
+	"0":     level.debug,
+}
+
+// envLevelNames is the list of environment variables SetMinLevel falls
+// back to when given an unrecognized name. LoadLevelFromEnv sets this.
+var envLevelNames []string
+
+// MustParseLevel resolves name (case-insensitive, accepting the
+// aliases above and numeric priorities 0-5) to the internal *Level. It
+// panics if name isn't recognized, so downstream code can compare
+// against level.error etc. without reaching into the unexported level
+// struct.
+func MustParseLevel(name string) *Level {
+	lvl, ok := levelByName[strings.ToLower(name)]
+	if !ok {
+		panic(fmt.Sprintf("logging: unknown level %q", name))
+	}
+	return lvl
+}
+
+// LogLevelFlag is a pflag.Value wrapping one of this module's levels,
+// for binaries that want a --log-level flag. Setting it also stores
+// the level's priority in minPriority, as SetMinLevel does.
+type LogLevelFlag struct {
+	Level *Level
+}
+
+// Set implements pflag.Value.
+func (f *LogLevelFlag) Set(value string) error {
+	lvl, ok := levelByName[strings.ToLower(value)]
+	if !ok {
+		return fmt.Errorf("logging: unknown level %q", value)
+	}
+	f.Level = lvl
+	minPriority.Store(int32(lvl.priority))
+	return nil
+}
+
+// Type implements pflag.Value.
+func (f *LogLevelFlag) Type() string {
+	return "level"
+}
+
+// String implements pflag.Value.
+func (f *LogLevelFlag) String() string {
+	if f.Level == nil {
+		return ""
+	}
+	return f.Level.name
+}
+
+// LoadLevelFromEnv reads the first set, non-empty environment variable
+// among names and applies it via SetMinLevel. It also remembers names
+// so a later SetMinLevel call with an unrecognized value falls back to
+// them instead of silently defaulting to Debug.
+func LoadLevelFromEnv(names ...string) {
+	envLevelNames = names
+	for _, name := range names {
+		value, ok := os.LookupEnv(name)
+		if !ok || value == "" {
+			continue
+		}
+		SetMinLevel(value)
+		return
+	}
+}
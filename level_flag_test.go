@@ -0,0 +1,79 @@
+package logging
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type LevelFlagSuite struct {
+	suite.Suite
+}
+
+func (suite *LevelFlagSuite) TestSetAcceptsAliasesCaseInsensitively() {
+	prior := minPriority.Load()
+	defer minPriority.Store(prior)
+
+	var flag LogLevelFlag
+	suite.Require().NoError(flag.Set("WARNING"))
+	suite.Require().Equal(level.warn, flag.Level)
+	suite.Require().Equal("Warn", flag.String())
+	suite.Require().Equal("level", flag.Type())
+}
+
+func (suite *LevelFlagSuite) TestSetRejectsUnknownLevel() {
+	var flag LogLevelFlag
+	suite.Require().Error(flag.Set("nope"))
+	suite.Require().Nil(flag.Level)
+	suite.Require().Equal("", flag.String())
+}
+
+func (suite *LevelFlagSuite) TestSetAppliesMinPriority() {
+	prior := minPriority.Load()
+	defer minPriority.Store(prior)
+
+	var flag LogLevelFlag
+	suite.Require().NoError(flag.Set("error"))
+	suite.Require().Equal(int32(level.error.priority), minPriority.Load())
+}
+
+func (suite *LevelFlagSuite) TestMustParseLevelPanicsOnUnknown() {
+	suite.Require().Panics(func() {
+		MustParseLevel("nope")
+	})
+	suite.Require().Equal(level.notice, MustParseLevel("2"))
+}
+
+func (suite *LevelFlagSuite) TestLoadLevelFromEnvUsesFirstSetVar() {
+	prior := minPriority.Load()
+	priorNames := envLevelNames
+	defer func() {
+		minPriority.Store(prior)
+		envLevelNames = priorNames
+	}()
+
+	suite.T().Setenv("LOGGING_TEST_LEVEL_A", "")
+	suite.T().Setenv("LOGGING_TEST_LEVEL_B", "error")
+
+	LoadLevelFromEnv("LOGGING_TEST_LEVEL_A", "LOGGING_TEST_LEVEL_B")
+	suite.Require().Equal(int32(level.error.priority), minPriority.Load())
+}
+
+func (suite *LevelFlagSuite) TestSetMinLevelFallsBackToEnv() {
+	prior := minPriority.Load()
+	priorNames := envLevelNames
+	defer func() {
+		minPriority.Store(prior)
+		envLevelNames = priorNames
+	}()
+
+	suite.T().Setenv("LOGGING_TEST_LEVEL_C", "notice")
+	LoadLevelFromEnv("LOGGING_TEST_LEVEL_C")
+
+	SetMinLevel("not-a-real-level")
+	suite.Require().Equal(int32(level.notice.priority), minPriority.Load())
+}
+
+func TestLevelFlag(t *testing.T) {
+	suite.Run(t, new(LevelFlagSuite))
+}
@@ -7,7 +7,10 @@ import (
 	"os"
 	"path/filepath"
 	"runtime"
+	"strings"
 	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/fatih/color"
 )
@@ -21,7 +24,11 @@ var (
 	gitVersion   string
 	buildVersion string
 
-	minPriority int
+	// minPriority is read on every logging call, including ones that
+	// end up filtered out, so it's an atomic rather than a plain int:
+	// SetMinLevel must be safe to call while other goroutines are
+	// logging.
+	minPriority atomic.Int32
 
 	level = struct {
 		fatal  *Level
@@ -58,37 +65,32 @@ func DefaultLogger() Logger {
 	return defaultLogger.value
 }
 
-// SetMinLevel sets minPriority.
+// SetMinLevel sets minPriority from minLevel (case-insensitive, the
+// aliases accepted by LogLevelFlag, or a numeric priority). If minLevel
+// isn't recognized, it falls back to the first env var LoadLevelFromEnv
+// was last told to watch that holds a recognized level; only when none
+// does is Debug used.
 func SetMinLevel(minLevel string) {
-	switch minLevel {
-	case level.fatal.name:
-		minPriority = level.fatal.priority
-		return
-	case level.error.name:
-		minPriority = level.error.priority
-		return
-	case level.warn.name:
-		minPriority = level.warn.priority
-		return
-	case level.notice.name:
-		minPriority = level.notice.priority
-		return
-	case level.info.name:
-		minPriority = level.info.priority
-		return
-	case level.debug.name:
-		minPriority = level.debug.priority
+	if lvl, ok := levelByName[strings.ToLower(minLevel)]; ok {
+		minPriority.Store(int32(lvl.priority))
 		return
 	}
 
-	minPriority = level.debug.priority
-}
-
-func printf(output io.Writer, depth int, level *Level, msg string) {
-	if level.priority < minPriority {
-		return
+	for _, name := range envLevelNames {
+		value, ok := os.LookupEnv(name)
+		if !ok || value == "" {
+			continue
+		}
+		if lvl, ok := levelByName[strings.ToLower(value)]; ok {
+			minPriority.Store(int32(lvl.priority))
+			return
+		}
 	}
 
+	minPriority.Store(int32(level.debug.priority))
+}
+
+func buildEntry(depth int, level *Level, msg string, fields []*Field) *Entry {
 	buffer := make([]byte, 64)
 	buffer = buffer[:runtime.Stack(buffer, false)]
 	bufList := bytes.Fields(buffer)
@@ -107,39 +109,40 @@ func printf(output io.Writer, depth int, level *Level, msg string) {
 		file = filepath.Base(file)
 	}
 
-	if len(msg) != 0 {
-		msg = " " + msg
+	entry := acquireEntry()
+	entry.Level = level
+	entry.Time = time.Now()
+	entry.Message = msg
+	entry.File = file
+	entry.Line = line
+	entry.Function = funcName
+	entry.GoroutineID = goID
+	entry.Fields = append(entry.Fields[:0], fields...)
+	return entry
+}
+
+func printf(
+	output io.Writer, formatter Formatter, depth int, level *Level,
+	msg string, fields []*Field) {
+	entry := buildEntry(depth+1, level, msg, fields)
+	formatted, err := formatter.Format(entry)
+	if err == nil {
+		output.Write(formatted)
 	}
-
-	fmt.Fprintf(
-		output,
-		"%s %s%s:%s %s:%s:%s {git:%s, build:%s}\n",
-		level.colorFunc("["+level.name+"]"),
-		goTagColorFunc("Go"),
-		goIDColorFunc(goID),
-		msg,
-		funcNameColorFunc(funcName+"()"),
-		fileColorFunc(file),
-		lineColorFunc(line),
-		gitVersion,
-		buildVersion)
+	releaseEntry(entry)
 }
 
+// sprint joins the non-Field values of valueList into the record's
+// free-text message, comma-separated. *Field values are skipped here,
+// not stringified: they're rendered on their own, as typed top-level
+// keys by jsonFormatter and as k(v) text by textFormatter, so baking
+// their Sprint() output into the message would duplicate them.
 func sprint(valueList ...interface{}) string {
-	translatedList := make([]interface{}, 0, len(valueList))
-	translateField := func(f *Field) {
-		translatedList = append(translatedList, f.Sprint()...)
-	}
+	formedList := make([]interface{}, 0, len(valueList)*2)
 	for _, value := range valueList {
-		switch v := value.(type) {
-		case *Field:
-			translateField(v)
-		default:
-			translatedList = append(translatedList, value)
+		if _, ok := value.(*Field); ok {
+			continue
 		}
-	}
-	formedList := make([]interface{}, 0, len(translatedList)*2)
-	for _, value := range translatedList {
 		formedList = append(formedList, value, ", ")
 	}
 	end := len(formedList)
@@ -215,17 +218,59 @@ type Level struct {
 func NewLogger() (Logger, error) {
 	logger := new(concreteLogger)
 	logger.output = os.Stdout
+	logger.formatter = NewTextFormatter()
 	return logger, nil
 }
 
 type concreteLogger struct {
-	output io.Writer
+	output    io.Writer
+	formatter Formatter
+
+	parent     *concreteLogger
+	baseFields []*Field
+}
+
+// fields walks the parent chain once, returning the effective baseline
+// field list, root-first, for this logger.
+func (logger *concreteLogger) fields() []*Field {
+	var ancestors []*concreteLogger
+	for l := logger; l != nil; l = l.parent {
+		ancestors = append(ancestors, l)
+	}
+
+	var chain []*Field
+	for i := len(ancestors) - 1; i >= 0; i-- {
+		chain = append(chain, ancestors[i].baseFields...)
+	}
+	return chain
+}
+
+// withBaseFields prepends the logger's accumulated baseline fields to
+// v, so a later, more specific Field for the same key shadows an
+// earlier one at emission time.
+func (logger *concreteLogger) withBaseFields(v []interface{}) []interface{} {
+	chain := logger.fields()
+	if len(chain) == 0 {
+		return v
+	}
+
+	combined := make([]interface{}, 0, len(chain)+len(v))
+	for _, field := range chain {
+		combined = append(combined, field)
+	}
+	return append(combined, v...)
 }
 
 func (logger *concreteLogger) SetOutput(output io.Writer) {
 	logger.output = output
 }
 
+// SetFormatter replaces the Formatter used to render every subsequent
+// record emitted by logger.
+func (logger *concreteLogger) SetFormatter(formatter Formatter) {
+	logger.formatter = formatter
+}
+
 func (logger *concreteLogger) Fatal(v ...interface{}) {
 	logger.Fatald(3, v...)
 }
@@ -267,57 +312,129 @@ func (logger *concreteLogger) Infof(format string, v ...interface{}) {
 }
 
 func (logger *concreteLogger) Fatald(depth int, v ...interface{}) {
-	printf(logger.output, depth, level.fatal, sprint(v...))
+	if level.fatal.priority < int(minPriority.Load()) {
+		return
+	}
+	v = logger.withBaseFields(v)
+	printf(
+		logger.output, logger.formatter, depth, level.fatal, sprint(v...),
+		fieldsOf(v))
 }
 
 func (logger *concreteLogger) Fataldf(
 	depth int, format string, v ...interface{}) {
-	printf(logger.output, depth, level.fatal, sprintf(format, v...))
+	if level.fatal.priority < int(minPriority.Load()) {
+		return
+	}
+	v = logger.withBaseFields(v)
+	printf(
+		logger.output, logger.formatter, depth, level.fatal,
+		sprintf(format, v...), fieldsOf(v))
 }
 
 func (logger *concreteLogger) Errord(depth int, v ...interface{}) {
-	printf(logger.output, depth, level.error, sprint(v...))
+	if level.error.priority < int(minPriority.Load()) {
+		return
+	}
+	v = logger.withBaseFields(v)
+	printf(
+		logger.output, logger.formatter, depth, level.error, sprint(v...),
+		fieldsOf(v))
 }
 
 func (logger *concreteLogger) Errordf(
 	depth int, format string, v ...interface{}) {
-	printf(logger.output, depth, level.error, sprintf(format, v...))
+	if level.error.priority < int(minPriority.Load()) {
+		return
+	}
+	v = logger.withBaseFields(v)
+	printf(
+		logger.output, logger.formatter, depth, level.error,
+		sprintf(format, v...), fieldsOf(v))
 }
 
 func (logger *concreteLogger) Warnd(depth int, v ...interface{}) {
-	printf(logger.output, depth, level.warn, sprint(v...))
+	if level.warn.priority < int(minPriority.Load()) {
+		return
+	}
+	v = logger.withBaseFields(v)
+	printf(
+		logger.output, logger.formatter, depth, level.warn, sprint(v...),
+		fieldsOf(v))
 }
 
 func (logger *concreteLogger) Warndf(
 	depth int, format string, v ...interface{}) {
-	printf(logger.output, depth, level.warn, sprintf(format, v...))
+	if level.warn.priority < int(minPriority.Load()) {
+		return
+	}
+	v = logger.withBaseFields(v)
+	printf(
+		logger.output, logger.formatter, depth, level.warn,
+		sprintf(format, v...), fieldsOf(v))
 }
 
 func (logger *concreteLogger) Noticed(depth int, v ...interface{}) {
-	printf(logger.output, depth, level.notice, sprint(v...))
+	if level.notice.priority < int(minPriority.Load()) {
+		return
+	}
+	v = logger.withBaseFields(v)
+	printf(
+		logger.output, logger.formatter, depth, level.notice, sprint(v...),
+		fieldsOf(v))
 }
 
 func (logger *concreteLogger) Noticedf(
 	depth int, format string, v ...interface{}) {
-	printf(logger.output, depth, level.notice, sprintf(format, v...))
+	if level.notice.priority < int(minPriority.Load()) {
+		return
+	}
+	v = logger.withBaseFields(v)
+	printf(
+		logger.output, logger.formatter, depth, level.notice,
+		sprintf(format, v...), fieldsOf(v))
 }
 
 func (logger *concreteLogger) Infod(depth int, v ...interface{}) {
-	printf(logger.output, depth, level.info, sprint(v...))
+	if level.info.priority < int(minPriority.Load()) {
+		return
+	}
+	v = logger.withBaseFields(v)
+	printf(
+		logger.output, logger.formatter, depth, level.info, sprint(v...),
+		fieldsOf(v))
 }
 
 func (logger *concreteLogger) Infodf(
 	depth int, format string, v ...interface{}) {
-	printf(logger.output, depth, level.info, sprintf(format, v...))
+	if level.info.priority < int(minPriority.Load()) {
+		return
+	}
+	v = logger.withBaseFields(v)
+	printf(
+		logger.output, logger.formatter, depth, level.info,
+		sprintf(format, v...), fieldsOf(v))
 }
 
 func (logger *concreteLogger) Debugd(depth int, v ...interface{}) {
-	printf(logger.output, depth, level.debug, sprint(v...))
+	if level.debug.priority < int(minPriority.Load()) {
+		return
+	}
+	v = logger.withBaseFields(v)
+	printf(
+		logger.output, logger.formatter, depth, level.debug, sprint(v...),
+		fieldsOf(v))
 }
 
 func (logger *concreteLogger) Debugdf(
 	depth int, format string, v ...interface{}) {
-	printf(logger.output, depth, level.debug, sprintf(format, v...))
+	if level.debug.priority < int(minPriority.Load()) {
+		return
+	}
+	v = logger.withBaseFields(v)
+	printf(
+		logger.output, logger.formatter, depth, level.debug,
+		sprintf(format, v...), fieldsOf(v))
 }
 
 type Field struct {
@@ -347,14 +464,20 @@ func NewErrField(value interface{}) *Field {
 func (f *Field) Sprint() []interface{} {
 	translatedList := make([]interface{}, 0, len(f.keys))
 	for _, key := range f.keys {
-		_, exist := f.values[key]
+		value, exist := f.values[key]
 		if !exist {
 			translatedList = append(translatedList, key)
 			continue
 		}
+		if key == "trace_id" || key == "span_id" {
+			translatedList = append(
+				translatedList,
+				fmt.Sprintf(
+					"%s(%s)", fieldColorFunc(key), traceIDColorFunc(value)))
+			continue
+		}
 		translatedList = append(
-			translatedList,
-			fmt.Sprintf("%s(%v)", fieldColorFunc(key), f.values[key]))
+			translatedList, fmt.Sprintf("%s(%v)", fieldColorFunc(key), value))
 	}
 	return translatedList
 }
@@ -370,3 +493,13 @@ func (f *Field) Add(key string, value interface{}) *Field {
 	f.values[key] = value
 	return f
 }
+
+// set is Add without the no-overwrite guard, used by fieldsOf to merge
+// several *Field arguments into one, later values shadowing earlier
+// ones for the same key.
+func (f *Field) set(key string, value interface{}) {
+	if _, exist := f.values[key]; !exist {
+		f.keys = append(f.keys, key)
+	}
+	f.values[key] = value
+}
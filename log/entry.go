@@ -0,0 +1,79 @@
+package log
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Entry is the structured representation of a single log record. It is
+// built once per emitted line so that a Formatter never has to re-parse
+// the message or re-derive caller information.
+type Entry struct {
+	Level       *Level
+	Time        time.Time
+	Message     string
+	File        string
+	Line        int
+	Function    string
+	GoroutineID string
+}
+
+// Formatter renders an Entry into the bytes written to stdout.
+type Formatter interface {
+	Format(entry *Entry) ([]byte, error)
+}
+
+// textFormatter reproduces the original colored, space-separated format.
+type textFormatter struct{}
+
+// NewTextFormatter returns the default colored text Formatter.
+func NewTextFormatter() Formatter {
+	return &textFormatter{}
+}
+
+func (f *textFormatter) Format(entry *Entry) ([]byte, error) {
+	msg := entry.Message
+	if len(msg) != 0 {
+		msg = " " + msg
+	}
+
+	line := fmt.Sprintf(
+		"%s %s%s:%s %s:%s:%s {git:%s, build:%s}\n",
+		entry.Level.colorFunc("["+entry.Level.name+"]"),
+		goTagColorFunc("Go"),
+		goIdColorFunc(entry.GoroutineID),
+		msg,
+		funcNameColorFunc(entry.Function+"()"),
+		fileColorFunc(entry.File),
+		lineColorFunc(entry.Line),
+		gitVersion,
+		buildVersion)
+	return []byte(line), nil
+}
+
+// jsonFormatter emits one JSON object per line, modeled on hclog's
+// intlogger.
+type jsonFormatter struct{}
+
+// NewJSONFormatter returns a Formatter that emits structured JSON lines.
+func NewJSONFormatter() Formatter {
+	return &jsonFormatter{}
+}
+
+func (f *jsonFormatter) Format(entry *Entry) ([]byte, error) {
+	out := map[string]interface{}{
+		"@timestamp": entry.Time.Format("2006-01-02T15:04:05.000Z07:00"),
+		"@level":     entry.Level.name,
+		"@message":   entry.Message,
+		"@caller":    fmt.Sprintf("%s:%d", entry.File, entry.Line),
+		"@function":  entry.Function,
+		"@goroutine": entry.GoroutineID,
+	}
+
+	encoded, err := json.Marshal(out)
+	if err != nil {
+		return nil, err
+	}
+	return append(encoded, '\n'), nil
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"path/filepath"
 	"runtime"
+	"time"
 
 	"github.com/fatih/color"
 )
@@ -21,6 +22,8 @@ var (
 
 	minPriority int
 
+	formatter Formatter = NewTextFormatter()
+
 	level = struct {
 		fatal  *Level
 		error  *Level
@@ -68,6 +71,12 @@ func SetMinLevel(minLevel string) {
 	minPriority = level.debug.priority
 }
 
+// SetFormatter replaces the Formatter used to render every subsequent
+// record.
+func SetFormatter(f Formatter) {
+	formatter = f
+}
+
 func Fatal(v ...interface{}) {
 	printf(level.fatal, "", v...)
 }
@@ -147,19 +156,19 @@ func printf(level *Level, format string, v ...interface{}) {
 			msg = fmt.Sprint(v...)
 		}
 	}
-	if len(msg) != 0 {
-		msg = " " + msg
-	}
 
-	fmt.Printf(
-		"%s %s%s:%s %s:%s:%s {git:%s, build:%s}\n",
-		level.colorFunc("["+level.name+"]"),
-		goTagColorFunc("Go"),
-		goIdColorFunc(goId),
-		msg,
-		funcNameColorFunc(funcName+"()"),
-		fileColorFunc(file),
-		lineColorFunc(line),
-		gitVersion,
-		buildVersion)
+	entry := &Entry{
+		Level:       level,
+		Time:        time.Now(),
+		Message:     msg,
+		File:        file,
+		Line:        line,
+		Function:    funcName,
+		GoroutineID: goId,
+	}
+	formatted, err := formatter.Format(entry)
+	if err != nil {
+		return
+	}
+	fmt.Print(string(formatted))
 }
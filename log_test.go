@@ -75,38 +75,35 @@ func (suite *DefaultLoggerSuite) TestErrorField() {
 	suite.Require().Contains(output, msg0)
 
 	buffer.Reset()
-	logger.Error(Field{})
+	logger.Error(NewField())
 	output = buffer.String()
 	suite.Require().Contains(output, level.error.name)
 
-	field0 := Field{
-		"bool": true,
-		"int":  10,
-	}
+	field0 := NewField("bool", true, "int", 10)
 	buffer.Reset()
 	logger.Error(field0)
 	output = buffer.String()
 	suite.Require().Contains(output, level.error.name)
-	for key, value := range field0 {
-		suite.Require().Contains(output, fmt.Sprintf("%s(%v)", key, value))
+	for _, key := range field0.keys {
+		suite.Require().Contains(
+			output, fmt.Sprintf("%s(%v)", key, field0.values[key]))
 	}
 
 	msg1 := "2c9a3582-2990-42c5-89cf-4c6f9cde4e1e"
-	field1 := Field{
-		"float":  23.4,
-		"string": "abc",
-	}
+	field1 := NewField("float", 23.4, "string", "abc")
 	buffer.Reset()
-	logger.Error(msg0, field0, msg1, &field1)
+	logger.Error(msg0, field0, msg1, field1)
 	output = buffer.String()
 	suite.Require().Contains(output, level.error.name)
 	suite.Require().Contains(output, msg0)
 	suite.Require().Contains(output, msg1)
-	for key, value := range field0 {
-		suite.Require().Contains(output, fmt.Sprintf("%s(%v)", key, value))
+	for _, key := range field0.keys {
+		suite.Require().Contains(
+			output, fmt.Sprintf("%s(%v)", key, field0.values[key]))
 	}
-	for key, value := range field1 {
-		suite.Require().Contains(output, fmt.Sprintf("%s(%v)", key, value))
+	for _, key := range field1.keys {
+		suite.Require().Contains(
+			output, fmt.Sprintf("%s(%v)", key, field1.values[key]))
 	}
 }
 
@@ -122,62 +119,61 @@ func (suite *DefaultLoggerSuite) TestWarnfField() {
 	suite.Require().True(ok)
 	concrete.SetOutput(&buffer)
 	msg0 := "dfdffdc4-7281-4b77-8ed7-9b07a10ab354"
-	logger.Errorf(msg0)
+	logger.Warnf(msg0)
 	output := buffer.String()
 	suite.Require().Contains(output, level.warn.name)
 	suite.Require().Contains(output, msg0)
 
 	buffer.Reset()
-	logger.Errorf("%s", msg0)
+	logger.Warnf("%s", msg0)
 	output = buffer.String()
 	suite.Require().Contains(output, level.warn.name)
 	suite.Require().Contains(output, msg0)
 
-	logger.Errorf(msg0, Field{})
+	buffer.Reset()
+	logger.Warnf(msg0, NewField())
 	output = buffer.String()
 	suite.Require().Contains(output, level.warn.name)
 	suite.Require().Contains(output, msg0)
 
-	field0 := Field{
-		"bool": true,
-		"int":  10,
-	}
+	field0 := NewField("bool", true, "int", 10)
 	buffer.Reset()
-	logger.Errorf(msg0, field0)
+	logger.Warnf(msg0, field0)
 	output = buffer.String()
 	suite.Require().Contains(output, level.warn.name)
 	suite.Require().Contains(output, msg0)
-	for key, value := range field0 {
-		suite.Require().Contains(output, fmt.Sprintf("%s(%v)", key, value))
+	for _, key := range field0.keys {
+		suite.Require().Contains(
+			output, fmt.Sprintf("%s(%v)", key, field0.values[key]))
 	}
 
 	buffer.Reset()
-	logger.Errorf("%s", msg0, field0)
+	logger.Warnf("%s", msg0, field0)
 	output = buffer.String()
 	suite.Require().Contains(output, level.warn.name)
 	suite.Require().Contains(output, msg0)
-	for key, value := range field0 {
-		suite.Require().Contains(output, fmt.Sprintf("%s(%v)", key, value))
+	for _, key := range field0.keys {
+		suite.Require().Contains(
+			output, fmt.Sprintf("%s(%v)", key, field0.values[key]))
 	}
 
 	msg1 := "2c9a3582-2990-42c5-8cf-4c6f9cde4e1e"
 	msg2 := "5ac9795c-2c06-43b1-aae8-d72a8f573738"
-	field1 := Field{
-		"float":  23.4,
-		"string": "abc",
-	}
+	field1 := NewField("float", 23.4, "string", "abc")
 	buffer.Reset()
-	logger.Errorf("%s, %s", msg0, msg1, field0, msg2, &field1)
+	logger.Warnf("%s, %s", msg0, msg1, field0, msg2, field1)
 	output = buffer.String()
 	suite.Require().Contains(output, level.warn.name)
 	suite.Require().Contains(output, msg0)
 	suite.Require().Contains(output, msg1)
 	suite.Require().Contains(output, msg2)
-	for key, value := range field0 {
-		suite.Require().Contains(output, fmt.Sprintf("%s(%v)", key, value))
+	for _, key := range field0.keys {
+		suite.Require().Contains(
+			output, fmt.Sprintf("%s(%v)", key, field0.values[key]))
 	}
-	for key, value := range field1 {
-		suite.Require().Contains(output, fmt.Sprintf("%s(%v)", key, value))
+	for _, key := range field1.keys {
+		suite.Require().Contains(
+			output, fmt.Sprintf("%s(%v)", key, field1.values[key]))
 	}
 }
 
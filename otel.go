@@ -0,0 +1,204 @@
+package logging
+
+import (
+	"context"
+
+	"github.com/fatih/color"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var traceIDColorFunc = color.New(color.FgHiCyan).SprintFunc()
+
+// ctxLogger wraps a Logger, injecting trace_id/span_id fields drawn
+// from the OpenTelemetry span active on ctx (if any) into every record
+// it emits. It implements LoggerWithDepth, forwarding to next one frame
+// deeper, so wrapping a logger in WithContext doesn't break caller
+// attribution.
+type ctxLogger struct {
+	next Logger
+	ctx  context.Context
+}
+
+// WithContext returns a child Logger that correlates every record it
+// emits with the OpenTelemetry span active on ctx.
+func (logger *concreteLogger) WithContext(ctx context.Context) Logger {
+	return &ctxLogger{next: logger, ctx: ctx}
+}
+
+func (l *ctxLogger) spanField() *Field {
+	span := trace.SpanContextFromContext(l.ctx)
+	if !span.IsValid() {
+		return nil
+	}
+	return NewField(
+		"trace_id", span.TraceID().String(),
+		"span_id", span.SpanID().String())
+}
+
+func (l *ctxLogger) withSpan(v []interface{}) []interface{} {
+	field := l.spanField()
+	if field == nil {
+		return v
+	}
+	return append(append([]interface{}{}, v...), field)
+}
+
+func (l *ctxLogger) Fatal(v ...interface{}) {
+	l.Fatald(3, v...)
+}
+
+func (l *ctxLogger) Fatalf(format string, v ...interface{}) {
+	l.Fataldf(3, format, v...)
+}
+
+func (l *ctxLogger) Error(v ...interface{}) {
+	l.Errord(3, v...)
+}
+
+func (l *ctxLogger) Errorf(format string, v ...interface{}) {
+	l.Errordf(3, format, v...)
+}
+
+func (l *ctxLogger) Warn(v ...interface{}) {
+	l.Warnd(3, v...)
+}
+
+func (l *ctxLogger) Warnf(format string, v ...interface{}) {
+	l.Warndf(3, format, v...)
+}
+
+func (l *ctxLogger) Notice(v ...interface{}) {
+	l.Noticed(3, v...)
+}
+
+func (l *ctxLogger) Noticef(format string, v ...interface{}) {
+	l.Noticedf(3, format, v...)
+}
+
+func (l *ctxLogger) Info(v ...interface{}) {
+	l.Infod(3, v...)
+}
+
+func (l *ctxLogger) Infof(format string, v ...interface{}) {
+	l.Infodf(3, format, v...)
+}
+
+func (l *ctxLogger) Debug(v ...interface{}) {
+	l.Debugd(3, v...)
+}
+
+func (l *ctxLogger) Debugf(format string, v ...interface{}) {
+	l.Debugdf(3, format, v...)
+}
+
+// Fatald adds the span fields and, when next also implements
+// LoggerWithDepth, forwards through its Xd method at depth+1 so caller
+// attribution skips over this frame; otherwise it falls back to next's
+// plain, depth-unaware method.
+func (l *ctxLogger) Fatald(depth int, v ...interface{}) {
+	v = l.withSpan(v)
+	if withDepth, ok := l.next.(LoggerWithDepth); ok {
+		withDepth.Fatald(depth+1, v...)
+		return
+	}
+	l.next.Fatal(v...)
+}
+
+func (l *ctxLogger) Fataldf(depth int, format string, v ...interface{}) {
+	v = l.withSpan(v)
+	if withDepth, ok := l.next.(LoggerWithDepth); ok {
+		withDepth.Fataldf(depth+1, format, v...)
+		return
+	}
+	l.next.Fatalf(format, v...)
+}
+
+func (l *ctxLogger) Errord(depth int, v ...interface{}) {
+	v = l.withSpan(v)
+	if withDepth, ok := l.next.(LoggerWithDepth); ok {
+		withDepth.Errord(depth+1, v...)
+		return
+	}
+	l.next.Error(v...)
+}
+
+func (l *ctxLogger) Errordf(depth int, format string, v ...interface{}) {
+	v = l.withSpan(v)
+	if withDepth, ok := l.next.(LoggerWithDepth); ok {
+		withDepth.Errordf(depth+1, format, v...)
+		return
+	}
+	l.next.Errorf(format, v...)
+}
+
+func (l *ctxLogger) Warnd(depth int, v ...interface{}) {
+	v = l.withSpan(v)
+	if withDepth, ok := l.next.(LoggerWithDepth); ok {
+		withDepth.Warnd(depth+1, v...)
+		return
+	}
+	l.next.Warn(v...)
+}
+
+func (l *ctxLogger) Warndf(depth int, format string, v ...interface{}) {
+	v = l.withSpan(v)
+	if withDepth, ok := l.next.(LoggerWithDepth); ok {
+		withDepth.Warndf(depth+1, format, v...)
+		return
+	}
+	l.next.Warnf(format, v...)
+}
+
+func (l *ctxLogger) Noticed(depth int, v ...interface{}) {
+	v = l.withSpan(v)
+	if withDepth, ok := l.next.(LoggerWithDepth); ok {
+		withDepth.Noticed(depth+1, v...)
+		return
+	}
+	l.next.Notice(v...)
+}
+
+func (l *ctxLogger) Noticedf(depth int, format string, v ...interface{}) {
+	v = l.withSpan(v)
+	if withDepth, ok := l.next.(LoggerWithDepth); ok {
+		withDepth.Noticedf(depth+1, format, v...)
+		return
+	}
+	l.next.Noticef(format, v...)
+}
+
+func (l *ctxLogger) Infod(depth int, v ...interface{}) {
+	v = l.withSpan(v)
+	if withDepth, ok := l.next.(LoggerWithDepth); ok {
+		withDepth.Infod(depth+1, v...)
+		return
+	}
+	l.next.Info(v...)
+}
+
+func (l *ctxLogger) Infodf(depth int, format string, v ...interface{}) {
+	v = l.withSpan(v)
+	if withDepth, ok := l.next.(LoggerWithDepth); ok {
+		withDepth.Infodf(depth+1, format, v...)
+		return
+	}
+	l.next.Infof(format, v...)
+}
+
+func (l *ctxLogger) Debugd(depth int, v ...interface{}) {
+	v = l.withSpan(v)
+	if withDepth, ok := l.next.(LoggerWithDepth); ok {
+		withDepth.Debugd(depth+1, v...)
+		return
+	}
+	l.next.Debug(v...)
+}
+
+func (l *ctxLogger) Debugdf(depth int, format string, v ...interface{}) {
+	v = l.withSpan(v)
+	if withDepth, ok := l.next.(LoggerWithDepth); ok {
+		withDepth.Debugdf(depth+1, format, v...)
+		return
+	}
+	l.next.Debugf(format, v...)
+}
@@ -0,0 +1,96 @@
+package logging
+
+import (
+	"context"
+
+	otellog "go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/log/embedded"
+)
+
+// Bridge adapts a Logger to OpenTelemetry's logs Bridge API
+// (go.opentelemetry.io/otel/log.Logger), so services already wired to
+// an OTel SDK can route their logs through this module without
+// standing up a second pipeline.
+type Bridge struct {
+	embedded.Logger
+
+	next LoggerWithDepth
+}
+
+// NewBridge returns an otellog.Logger that forwards every Record it
+// receives to next.
+func NewBridge(next Logger) *Bridge {
+	withDepth, ok := next.(LoggerWithDepth)
+	if !ok {
+		panic("logging: NewBridge requires a LoggerWithDepth")
+	}
+	return &Bridge{next: withDepth}
+}
+
+func otelSeverityToLevel(s otellog.Severity) *Level {
+	switch {
+	case s >= otellog.SeverityFatal1:
+		return level.fatal
+	case s >= otellog.SeverityError1:
+		return level.error
+	case s >= otellog.SeverityWarn1:
+		return level.warn
+	case s >= otellog.SeverityInfo1:
+		return level.info
+	default:
+		return level.debug
+	}
+}
+
+func otelValueToAny(v otellog.Value) interface{} {
+	switch v.Kind() {
+	case otellog.KindString:
+		return v.AsString()
+	case otellog.KindInt64:
+		return v.AsInt64()
+	case otellog.KindFloat64:
+		return v.AsFloat64()
+	case otellog.KindBool:
+		return v.AsBool()
+	case otellog.KindBytes:
+		return v.AsBytes()
+	default:
+		return v.String()
+	}
+}
+
+// Enabled reports whether a Record at the given severity would reach
+// next, so the OTel SDK can skip building Records that would only be
+// dropped downstream.
+func (b *Bridge) Enabled(_ context.Context, record otellog.Record) bool {
+	return otelSeverityToLevel(record.Severity()).priority >= int(minPriority.Load())
+}
+
+// Emit translates record into a Fatald/Errord/.../Debugd call on the
+// wrapped Logger, preserving its attributes as a Field.
+func (b *Bridge) Emit(_ context.Context, record otellog.Record) {
+	field := NewField()
+	record.WalkAttributes(func(kv otellog.KeyValue) bool {
+		field.Add(kv.Key, otelValueToAny(kv.Value))
+		return true
+	})
+
+	args := make([]interface{}, 0, 2)
+	args = append(args, record.Body().AsString())
+	if len(field.keys) != 0 {
+		args = append(args, field)
+	}
+
+	switch otelSeverityToLevel(record.Severity()) {
+	case level.fatal:
+		b.next.Fatald(3, args...)
+	case level.error:
+		b.next.Errord(3, args...)
+	case level.warn:
+		b.next.Warnd(3, args...)
+	case level.info:
+		b.next.Infod(3, args...)
+	default:
+		b.next.Debugd(3, args...)
+	}
+}
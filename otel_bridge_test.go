@@ -0,0 +1,66 @@
+package logging
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/fatih/color"
+	"github.com/stretchr/testify/suite"
+	otellog "go.opentelemetry.io/otel/log"
+)
+
+type BridgeSuite struct {
+	suite.Suite
+}
+
+func (suite *BridgeSuite) newBridge() (*Bridge, *strings.Builder) {
+	base := new(concreteLogger)
+	base.formatter = NewTextFormatter()
+	buffer := &strings.Builder{}
+	base.output = buffer
+	return NewBridge(base), buffer
+}
+
+func (suite *BridgeSuite) TestEnabledRespectsMinLevel() {
+	prior := minPriority.Load()
+	defer minPriority.Store(prior)
+	SetMinLevel(level.warn.name)
+
+	bridge, _ := suite.newBridge()
+	suite.Require().False(bridge.Enabled(context.Background(), debugRecord()))
+	suite.Require().True(bridge.Enabled(context.Background(), warnRecord()))
+}
+
+func (suite *BridgeSuite) TestEmitTranslatesSeverityAndAttributes() {
+	color.NoColor = true
+	defer func() { color.NoColor = false }()
+
+	bridge, buffer := suite.newBridge()
+	var record otellog.Record
+	record.SetBody(otellog.StringValue("hello from otel"))
+	record.SetSeverity(otellog.SeverityError1)
+	record.AddAttributes(otellog.String("component", "bridge"))
+
+	bridge.Emit(context.Background(), record)
+	output := buffer.String()
+	suite.Require().Contains(output, level.error.name)
+	suite.Require().Contains(output, "hello from otel")
+	suite.Require().Contains(output, "component(bridge)")
+}
+
+func debugRecord() otellog.Record {
+	var record otellog.Record
+	record.SetSeverity(otellog.SeverityDebug1)
+	return record
+}
+
+func warnRecord() otellog.Record {
+	var record otellog.Record
+	record.SetSeverity(otellog.SeverityWarn1)
+	return record
+}
+
+func TestBridge(t *testing.T) {
+	suite.Run(t, new(BridgeSuite))
+}
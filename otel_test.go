@@ -0,0 +1,83 @@
+package logging
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/fatih/color"
+	"github.com/stretchr/testify/suite"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type CtxLoggerSuite struct {
+	suite.Suite
+}
+
+func (suite *CtxLoggerSuite) newCtxLogger() (Logger, *strings.Builder) {
+	base := new(concreteLogger)
+	base.formatter = NewTextFormatter()
+	buffer := &strings.Builder{}
+	base.output = buffer
+	return base.WithContext(context.Background()), buffer
+}
+
+func (suite *CtxLoggerSuite) TestInjectsTraceAndSpanID() {
+	color.NoColor = true
+	defer func() { color.NoColor = false }()
+
+	var traceID trace.TraceID
+	for i := range traceID {
+		traceID[i] = byte(i + 1)
+	}
+	var spanID trace.SpanID
+	for i := range spanID {
+		spanID[i] = byte(i + 1)
+	}
+	spanCtx := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    traceID,
+		SpanID:     spanID,
+		TraceFlags: trace.FlagsSampled,
+	})
+	ctx := trace.ContextWithSpanContext(context.Background(), spanCtx)
+
+	base := new(concreteLogger)
+	base.formatter = NewTextFormatter()
+	buffer := &strings.Builder{}
+	base.output = buffer
+	logger := base.WithContext(ctx)
+
+	logger.Info("request handled")
+	output := buffer.String()
+	suite.Require().Contains(output, traceID.String())
+	suite.Require().Contains(output, spanID.String())
+}
+
+func (suite *CtxLoggerSuite) TestNoSpanOmitsFields() {
+	color.NoColor = true
+	defer func() { color.NoColor = false }()
+
+	logger, buffer := suite.newCtxLogger()
+	logger.Info("request handled")
+	suite.Require().NotContains(buffer.String(), "trace_id")
+}
+
+func (suite *CtxLoggerSuite) TestPreservesCallerAttribution() {
+	color.NoColor = true
+	defer func() { color.NoColor = false }()
+
+	logger, buffer := suite.newCtxLogger()
+	_, file, line, ok := runtime.Caller(0)
+	suite.Require().True(ok)
+	logger.Info("hello")
+	output := buffer.String()
+	suite.Require().Contains(output, filepath.Base(file))
+	suite.Require().Contains(output, fmt.Sprintf("%d", line+2))
+}
+
+func TestCtxLogger(t *testing.T) {
+	suite.Run(t, new(CtxLoggerSuite))
+}
@@ -0,0 +1,121 @@
+package logging
+
+import (
+	"context"
+	"log/slog"
+)
+
+// slogCallDepth points runtime.Caller past the Handle method, slog's
+// internal Logger.log, and the slog.Logger convenience method (Info,
+// Warn, ...) at the caller's own call site.
+const slogCallDepth = 5
+
+// slogHandler adapts a Logger to slog.Handler so libraries that already
+// emit via log/slog can be routed through this module.
+type slogHandler struct {
+	logger LoggerWithDepth
+	base   *Field
+	group  string
+}
+
+// NewSlogHandler returns an slog.Handler that forwards every record to
+// logger. logger must also implement LoggerWithDepth, which every
+// concreteLogger (and Filter wrapping one) does.
+func NewSlogHandler(logger Logger) slog.Handler {
+	withDepth, ok := logger.(LoggerWithDepth)
+	if !ok {
+		panic("logging: NewSlogHandler requires a LoggerWithDepth")
+	}
+	return &slogHandler{logger: withDepth, base: NewField()}
+}
+
+func slogLevelToLevel(l slog.Level) *Level {
+	switch {
+	case l >= slog.LevelError+4:
+		return level.fatal
+	case l >= slog.LevelError:
+		return level.error
+	case l >= slog.LevelWarn:
+		return level.warn
+	case l >= slog.LevelInfo:
+		return level.info
+	default:
+		return level.debug
+	}
+}
+
+func (h *slogHandler) Enabled(_ context.Context, l slog.Level) bool {
+	return slogLevelToLevel(l).priority >= int(minPriority.Load())
+}
+
+func (h *slogHandler) Handle(_ context.Context, record slog.Record) error {
+	attrField := NewField()
+	record.Attrs(func(attr slog.Attr) bool {
+		flattenSlogAttr(attrField, h.group, attr)
+		return true
+	})
+
+	args := make([]interface{}, 0, 3)
+	args = append(args, record.Message)
+	if len(h.base.keys) != 0 {
+		args = append(args, h.base)
+	}
+	if len(attrField.keys) != 0 {
+		args = append(args, attrField)
+	}
+
+	switch slogLevelToLevel(record.Level) {
+	case level.fatal:
+		h.logger.Fatald(slogCallDepth, args...)
+	case level.error:
+		h.logger.Errord(slogCallDepth, args...)
+	case level.warn:
+		h.logger.Warnd(slogCallDepth, args...)
+	case level.info:
+		h.logger.Infod(slogCallDepth, args...)
+	default:
+		h.logger.Debugd(slogCallDepth, args...)
+	}
+	return nil
+}
+
+func (h *slogHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	merged := NewField()
+	for _, key := range h.base.keys {
+		merged.Add(key, h.base.values[key])
+	}
+	for _, attr := range attrs {
+		flattenSlogAttr(merged, h.group, attr)
+	}
+	return &slogHandler{logger: h.logger, base: merged, group: h.group}
+}
+
+func (h *slogHandler) WithGroup(name string) slog.Handler {
+	group := name
+	if h.group != "" {
+		group = h.group + "." + name
+	}
+	return &slogHandler{logger: h.logger, base: h.base, group: group}
+}
+
+// flattenSlogAttr adds attr to field, recursing into GroupValue
+// attributes and joining nested keys with ".".
+func flattenSlogAttr(field *Field, prefix string, attr slog.Attr) {
+	attr.Value = attr.Value.Resolve()
+	if attr.Value.Kind() == slog.KindGroup {
+		groupPrefix := attr.Key
+		if prefix != "" {
+			groupPrefix = prefix + "." + attr.Key
+		}
+		for _, sub := range attr.Value.Group() {
+			flattenSlogAttr(field, groupPrefix, sub)
+		}
+		return
+	}
+
+	key := attr.Key
+	if prefix != "" {
+		key = prefix + "." + key
+	}
+	field.Add(key, attr.Value.Any())
+}
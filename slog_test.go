@@ -0,0 +1,90 @@
+package logging
+
+import (
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/fatih/color"
+	"github.com/stretchr/testify/suite"
+)
+
+type SlogHandlerSuite struct {
+	suite.Suite
+}
+
+func (suite *SlogHandlerSuite) newHandlerLogger() (
+	slog.Handler, *strings.Builder) {
+	base := new(concreteLogger)
+	base.formatter = NewTextFormatter()
+	buffer := &strings.Builder{}
+	base.output = buffer
+	return NewSlogHandler(base), buffer
+}
+
+func (suite *SlogHandlerSuite) TestAttrsAreFlattened() {
+	color.NoColor = true
+	defer func() { color.NoColor = false }()
+
+	handler, buffer := suite.newHandlerLogger()
+	logger := slog.New(handler)
+	logger.Info("request handled", "status", 200, "path", "/healthz")
+	output := buffer.String()
+	suite.Require().Contains(output, "status(200)")
+	suite.Require().Contains(output, "path(/healthz)")
+}
+
+func (suite *SlogHandlerSuite) TestGroupAttrsAreDotJoined() {
+	color.NoColor = true
+	defer func() { color.NoColor = false }()
+
+	handler, buffer := suite.newHandlerLogger()
+	logger := slog.New(handler)
+	logger.WithGroup("http").With("status", 200).Info(
+		"request handled", "path", "/healthz")
+	output := buffer.String()
+	suite.Require().Contains(output, "http.status(200)")
+	suite.Require().Contains(output, "http.path(/healthz)")
+}
+
+func (suite *SlogHandlerSuite) TestNewSlogHandlerAcceptsFilter() {
+	// NewSlogHandler's doc promises a Filter wrapping a concreteLogger
+	// satisfies LoggerWithDepth; this must not panic.
+	base := new(concreteLogger)
+	base.formatter = NewTextFormatter()
+	base.output = &strings.Builder{}
+	filtered := NewFilter(Logger(base))
+
+	suite.Require().NotPanics(func() {
+		NewSlogHandler(filtered)
+	})
+}
+
+func (suite *SlogHandlerSuite) TestHandlerRejectsPlainLogger() {
+	defer func() {
+		suite.Require().NotNil(recover())
+	}()
+	NewSlogHandler(plainLogger{})
+}
+
+// plainLogger implements Logger but not LoggerWithDepth.
+type plainLogger struct{}
+
+func (plainLogger) Fatal(v ...interface{})                 {}
+func (plainLogger) Fatalf(format string, v ...interface{}) {}
+func (plainLogger) Error(v ...interface{})                 {}
+func (plainLogger) Errorf(format string, v ...interface{}) {}
+func (plainLogger) Warn(v ...interface{})                  {}
+func (plainLogger) Warnf(format string, v ...interface{})  {}
+func (plainLogger) Notice(v ...interface{})                {}
+func (plainLogger) Noticef(format string, v ...interface{}) {
+}
+func (plainLogger) Info(v ...interface{})                 {}
+func (plainLogger) Infof(format string, v ...interface{}) {}
+func (plainLogger) Debug(v ...interface{})                {}
+func (plainLogger) Debugf(format string, v ...interface{}) {
+}
+
+func TestSlogHandler(t *testing.T) {
+	suite.Run(t, new(SlogHandlerSuite))
+}
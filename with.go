@@ -0,0 +1,21 @@
+package logging
+
+// With returns a child Logger sharing logger's output and formatter,
+// but carrying fields as a baseline that is prepended to every
+// subsequent record it emits. A later With call may shadow a key set
+// by an earlier one; the most specific value wins at emission,
+// regardless of Formatter — fieldsOf merges the baseline chain and the
+// call-site fields by key before a record is ever rendered.
+func (logger *concreteLogger) With(fields ...*Field) Logger {
+	return &concreteLogger{
+		output:     logger.output,
+		formatter:  logger.formatter,
+		parent:     logger,
+		baseFields: fields,
+	}
+}
+
+// WithField is a convenience for With(NewField(key, value)).
+func (logger *concreteLogger) WithField(key string, value interface{}) Logger {
+	return logger.With(NewField(key, value))
+}
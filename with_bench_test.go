@@ -0,0 +1,36 @@
+package logging
+
+import (
+	"io"
+	"testing"
+)
+
+func newBenchLogger(b *testing.B) *concreteLogger {
+	b.Helper()
+	logger, err := NewLogger()
+	if err != nil {
+		b.Fatal(err)
+	}
+	concrete := logger.(*concreteLogger)
+	concrete.SetOutput(io.Discard)
+	return concrete
+}
+
+func BenchmarkInfoRebuildFieldPerCall(b *testing.B) {
+	logger := newBenchLogger(b)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		logger.Info(NewField("service", "api", "req_id", i))
+	}
+}
+
+func BenchmarkInfoWithAccumulatedFields(b *testing.B) {
+	logger := newBenchLogger(b)
+	scoped := logger.With(NewField("service", "api"))
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		scoped.Info(NewField("req_id", i))
+	}
+}
@@ -0,0 +1,101 @@
+package logging
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/fatih/color"
+	"github.com/stretchr/testify/suite"
+)
+
+type WithSuite struct {
+	suite.Suite
+}
+
+func (suite *WithSuite) newConcrete() (*concreteLogger, *strings.Builder) {
+	logger, err := NewLogger()
+	suite.Require().NoError(err)
+	concrete := logger.(*concreteLogger)
+	buffer := &strings.Builder{}
+	concrete.SetOutput(buffer)
+	return concrete, buffer
+}
+
+func (suite *WithSuite) TestAccumulatesBaseFields() {
+	color.NoColor = true
+	defer func() { color.NoColor = false }()
+
+	logger, buffer := suite.newConcrete()
+	scoped := logger.With(NewField("service", "api"))
+	scoped.Info("handled")
+	suite.Require().Contains(buffer.String(), "service(api)")
+}
+
+// TestLaterFieldShadowsEarlier uses the JSON formatter because it's
+// the easiest place to assert a single surviving value: the record
+// ends up with one "env" key, holding the most specific value.
+func (suite *WithSuite) TestLaterFieldShadowsEarlier() {
+	logger, buffer := suite.newConcrete()
+	logger.SetFormatter(NewJSONFormatter())
+
+	outer := logger.With(NewField("env", "staging"))
+	inner := outer.(*concreteLogger).With(NewField("env", "prod"))
+	inner.Info("handled")
+
+	var record map[string]interface{}
+	suite.Require().NoError(json.Unmarshal([]byte(buffer.String()), &record))
+	suite.Require().Equal("prod", record["env"])
+}
+
+// TestLaterFieldShadowsEarlierInTextFormat proves the shadowing isn't
+// a JSON-only accident of map assignment: the default text formatter
+// must dedupe the same way, since With's doc comment promises it for
+// every Formatter, not just JSON consumers.
+func (suite *WithSuite) TestLaterFieldShadowsEarlierInTextFormat() {
+	color.NoColor = true
+	defer func() { color.NoColor = false }()
+
+	logger, buffer := suite.newConcrete()
+
+	outer := logger.With(NewField("env", "staging"))
+	inner := outer.(*concreteLogger).With(NewField("env", "prod"))
+	inner.Info("handled")
+
+	output := buffer.String()
+	suite.Require().Contains(output, "env(prod)")
+	suite.Require().NotContains(output, "env(staging)")
+}
+
+func (suite *WithSuite) TestWithFieldIsConvenienceForWith() {
+	color.NoColor = true
+	defer func() { color.NoColor = false }()
+
+	logger, buffer := suite.newConcrete()
+	scoped := logger.WithField("request_id", "abc-123")
+	scoped.Info("handled")
+	suite.Require().Contains(buffer.String(), "request_id(abc-123)")
+}
+
+func (suite *WithSuite) TestSiblingChildrenDoNotShareFields() {
+	color.NoColor = true
+	defer func() { color.NoColor = false }()
+
+	logger, buffer := suite.newConcrete()
+	base := logger.With(NewField("service", "api")).(*concreteLogger)
+	a := base.WithField("region", "us")
+	b := base.WithField("region", "eu")
+
+	a.Info("from a")
+	b.Info("from b")
+	lines := strings.Split(strings.TrimRight(buffer.String(), "\n"), "\n")
+	suite.Require().Len(lines, 2)
+	suite.Require().Contains(lines[0], "region(us)")
+	suite.Require().NotContains(lines[0], "region(eu)")
+	suite.Require().Contains(lines[1], "region(eu)")
+	suite.Require().NotContains(lines[1], "region(us)")
+}
+
+func TestWith(t *testing.T) {
+	suite.Run(t, new(WithSuite))
+}